@@ -0,0 +1,87 @@
+package guti
+
+import (
+	"context"
+	"reflect"
+)
+
+// Batch splits items into consecutive batches of at most batchSize elements.
+// The final batch may be smaller than batchSize when the length of items does
+// not divide evenly. It returns nil if items is nil, empty, or not a slice, or
+// if batchSize is not positive, rather than panicking or looping forever.
+func Batch(items interface{}, batchSize int) [][]interface{} {
+	itemsValue := reflect.ValueOf(items)
+	if !itemsValue.IsValid() || itemsValue.Kind() != reflect.Slice || itemsValue.Len() == 0 || batchSize <= 0 {
+		return nil
+	}
+
+	var batches [][]interface{}
+	for i := 0; i < itemsValue.Len(); i += batchSize {
+		end := i + batchSize
+		if end > itemsValue.Len() {
+			end = itemsValue.Len()
+		}
+		batches = append(batches, ConvertSliceInterfaceToSlice(itemsValue.Slice(i, end)))
+	}
+
+	return batches
+}
+
+// BatchStream splits items into batches of at most batchSize elements and
+// streams them over the returned channel, without ever materializing all
+// batches at once. The channel is closed once items is exhausted or ctx is
+// canceled. No batches are sent if items is nil, empty, or not a slice, or if
+// batchSize is not positive.
+func BatchStream(ctx context.Context, items interface{}, batchSize int) <-chan []interface{} {
+	out := make(chan []interface{})
+
+	go func() {
+		defer close(out)
+
+		itemsValue := reflect.ValueOf(items)
+		if !itemsValue.IsValid() || itemsValue.Kind() != reflect.Slice || itemsValue.Len() == 0 || batchSize <= 0 {
+			return
+		}
+
+		for i := 0; i < itemsValue.Len(); i += batchSize {
+			end := i + batchSize
+			if end > itemsValue.Len() {
+				end = itemsValue.Len()
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			batch := ConvertSliceInterfaceToSlice(itemsValue.Slice(i, end))
+			select {
+			case out <- batch:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// BatchFunc iterates over items in batches of at most batchSize elements,
+// calling fn with each batch in turn. It stops and returns the first error fn
+// returns.
+func BatchFunc(items interface{}, batchSize int, fn func([]interface{}) error) error {
+	for _, batch := range Batch(items, batchSize) {
+		if err := fn(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConvertSliceInterfaceToSlice takes a reflect.Value of a slice of unknown type and returns a new slice of interface{} type
+func ConvertSliceInterfaceToSlice(slice reflect.Value) []interface{} {
+	s := make([]interface{}, slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		s[i] = slice.Index(i).Interface()
+	}
+	return s
+}
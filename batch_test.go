@@ -0,0 +1,118 @@
+package guti
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBatchEdgeCases(t *testing.T) {
+	tests := []struct {
+		name      string
+		items     interface{}
+		batchSize int
+		want      [][]interface{}
+	}{
+		{"nil items", nil, 3, nil},
+		{"empty slice", []int{}, 3, nil},
+		{"zero batch size", []int{1, 2, 3}, 0, nil},
+		{"negative batch size", []int{1, 2, 3}, -1, nil},
+		{
+			"uneven division",
+			[]int{1, 2, 3, 4, 5},
+			2,
+			[][]interface{}{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			"batch size larger than input",
+			[]int{1, 2},
+			5,
+			[][]interface{}{{1, 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Batch(tt.items, tt.batchSize)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Batch(%v, %d) = %v, want %v", tt.items, tt.batchSize, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBatchStreamEdgeCases(t *testing.T) {
+	ctx := context.Background()
+
+	for batch := range BatchStream(ctx, nil, 3) {
+		t.Fatalf("BatchStream(nil, 3) yielded %v, want no batches", batch)
+	}
+
+	var got [][]interface{}
+	for batch := range BatchStream(ctx, []int{1, 2, 3, 4, 5}, 2) {
+		got = append(got, batch)
+	}
+	want := [][]interface{}{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BatchStream yielded %v, want %v", got, want)
+	}
+}
+
+func TestBatchStreamRespectsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ch := BatchStream(ctx, []int{1, 2, 3, 4, 5, 6}, 1)
+
+	var received int
+	timeout := time.After(time.Second)
+	for done := false; !done; {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				done = true
+				break
+			}
+			received++
+		case <-timeout:
+			t.Fatal("BatchStream did not close promptly after context cancellation")
+		}
+	}
+
+	if received == 6 {
+		t.Error("BatchStream yielded every batch despite the context being canceled upfront")
+	}
+}
+
+func TestBatchFunc(t *testing.T) {
+	var seen [][]interface{}
+	err := BatchFunc([]int{1, 2, 3, 4, 5}, 2, func(batch []interface{}) error {
+		seen = append(seen, batch)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("BatchFunc returned unexpected error: %v", err)
+	}
+	want := [][]interface{}{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(seen, want) {
+		t.Errorf("BatchFunc saw %v, want %v", seen, want)
+	}
+
+	boom := errors.New("boom")
+	calls := 0
+	err = BatchFunc([]int{1, 2, 3, 4}, 1, func(batch []interface{}) error {
+		calls++
+		if calls == 2 {
+			return boom
+		}
+		return nil
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("BatchFunc returned %v, want %v", err, boom)
+	}
+	if calls != 2 {
+		t.Fatalf("BatchFunc called fn %d times, want 2 (early exit)", calls)
+	}
+}
@@ -0,0 +1,400 @@
+// Package csvio provides reflection-based encoding and decoding between Go
+// structs and CSV. It extends guti.SaveAsCSV, which only supports string
+// fields, with typed field formatting, struct tag support, and a streaming
+// Encoder/Decoder API so large files can be processed row-by-row.
+//
+// Field names are taken from the struct field name unless overridden with a
+// `csv:"column_name"` tag. A tag of `csv:"-"` excludes the field entirely, and
+// `csv:"column_name,omitempty"` leaves the cell empty when the field holds its
+// zero value; the column itself is still written in the header.
+package csvio
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Options configures how an Encoder, Decoder, or the package-level
+// convenience functions read and write CSV.
+type Options struct {
+	// Delimiter is the field separator. It defaults to ',' when zero.
+	Delimiter rune
+	// LazyQuotes relaxes quote parsing on read, the same as csv.Reader.LazyQuotes.
+	LazyQuotes bool
+	// Strict causes a Decoder to return an error when the CSV header contains
+	// a column with no matching struct field, or a struct field's column is
+	// missing from the header. When false, both cases are ignored.
+	Strict bool
+}
+
+func (o Options) delimiter() rune {
+	if o.Delimiter == 0 {
+		return ','
+	}
+	return o.Delimiter
+}
+
+func firstOptions(opts []Options) Options {
+	if len(opts) == 0 {
+		return Options{}
+	}
+	return opts[0]
+}
+
+// fieldInfo describes how a struct field maps to a CSV column.
+type fieldInfo struct {
+	index     int
+	name      string
+	omitempty bool
+}
+
+// parseTag parses a field's `csv` tag into a fieldInfo. ok is false when the
+// field should be skipped entirely (csv:"-" or unexported).
+func parseTag(field reflect.StructField) (info fieldInfo, ok bool) {
+	if field.PkgPath != "" {
+		return fieldInfo{}, false
+	}
+
+	tag := field.Tag.Get("csv")
+	if tag == "-" {
+		return fieldInfo{}, false
+	}
+
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "" {
+		name = field.Name
+	}
+
+	omitempty := false
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+
+	return fieldInfo{name: name, omitempty: omitempty}, true
+}
+
+func fields(t reflect.Type) []fieldInfo {
+	infos := make([]fieldInfo, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		info, ok := parseTag(t.Field(i))
+		if !ok {
+			continue
+		}
+		info.index = i
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+var (
+	timeType           = reflect.TypeOf(time.Time{})
+	textMarshalerType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerPtr = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+func formatValue(v reflect.Value) (string, error) {
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).Format(time.RFC3339), nil
+	}
+	if v.Type().Implements(textMarshalerType) {
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String(), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("csvio: unsupported field type %s", v.Type())
+	}
+}
+
+func setValue(fv reflect.Value, raw string) error {
+	if raw == "" {
+		return nil
+	}
+
+	if fv.Type() == timeType {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if fv.CanAddr() && fv.Addr().Type().Implements(textUnmarshalerPtr) {
+		return fv.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(n)
+	default:
+		return fmt.Errorf("csvio: unsupported field type %s", fv.Type())
+	}
+	return nil
+}
+
+// Encoder writes structs to an underlying io.Writer as CSV rows. The header
+// row is written automatically by the first call to Encode, ahead of that
+// call's row.
+type Encoder struct {
+	w      *csv.Writer
+	infos  []fieldInfo
+	header bool
+}
+
+// NewEncoder returns an Encoder that writes CSV rows to w using opts.
+func NewEncoder(w io.Writer, opts Options) *Encoder {
+	cw := csv.NewWriter(w)
+	cw.Comma = opts.delimiter()
+	return &Encoder{w: cw}
+}
+
+// Encode writes v, which must be a struct or a pointer to a struct, as a
+// single CSV row.
+func (e *Encoder) Encode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("csvio: Encode requires a struct, got %s", rv.Kind())
+	}
+
+	if e.infos == nil {
+		e.infos = fields(rv.Type())
+	}
+
+	if !e.header {
+		header := make([]string, len(e.infos))
+		for i, info := range e.infos {
+			header[i] = info.name
+		}
+		if err := e.w.Write(header); err != nil {
+			return err
+		}
+		e.header = true
+	}
+
+	row := make([]string, len(e.infos))
+	for i, info := range e.infos {
+		fv := rv.Field(info.index)
+		if info.omitempty && fv.IsZero() {
+			continue
+		}
+		s, err := formatValue(fv)
+		if err != nil {
+			return err
+		}
+		row[i] = s
+	}
+	return e.w.Write(row)
+}
+
+// Flush flushes any buffered rows to the underlying writer. It must be called
+// once encoding is finished.
+func (e *Encoder) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// Decoder reads CSV rows from an underlying io.Reader, decoding each one into
+// a struct via Decode.
+type Decoder struct {
+	r       *csv.Reader
+	opts    Options
+	header  []string
+	infos   map[string]fieldInfo
+	started bool
+}
+
+// NewDecoder returns a Decoder that reads CSV rows from r using opts.
+func NewDecoder(r io.Reader, opts Options) *Decoder {
+	cr := csv.NewReader(r)
+	cr.Comma = opts.delimiter()
+	cr.LazyQuotes = opts.LazyQuotes
+	return &Decoder{r: cr, opts: opts}
+}
+
+// Decode reads the next CSV row into out, which must be a non-nil pointer to
+// a struct. It returns io.EOF once there are no more rows.
+func (d *Decoder) Decode(out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("csvio: Decode requires a pointer to struct, got %s", rv.Type())
+	}
+	elem := rv.Elem()
+
+	if !d.started {
+		header, err := d.r.Read()
+		if err != nil {
+			return err
+		}
+		d.header = header
+
+		d.infos = make(map[string]fieldInfo, len(header))
+		for _, info := range fields(elem.Type()) {
+			d.infos[info.name] = info
+		}
+
+		if d.opts.Strict {
+			known := make(map[string]bool, len(header))
+			for _, name := range header {
+				known[name] = true
+				if _, ok := d.infos[name]; !ok {
+					return fmt.Errorf("csvio: unknown column %q", name)
+				}
+			}
+			for name := range d.infos {
+				if !known[name] {
+					return fmt.Errorf("csvio: missing column %q", name)
+				}
+			}
+		}
+
+		d.started = true
+	}
+
+	row, err := d.r.Read()
+	if err != nil {
+		return err
+	}
+
+	for i, name := range d.header {
+		info, ok := d.infos[name]
+		if !ok || i >= len(row) {
+			continue
+		}
+		if err := setValue(elem.Field(info.index), row[i]); err != nil {
+			return fmt.Errorf("csvio: column %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Write encodes data, which must be a slice of structs or pointers to
+// structs, to w as CSV using opts.
+func Write(w io.Writer, data interface{}, opts ...Options) error {
+	rv := reflect.ValueOf(data)
+	if rv.Kind() != reflect.Slice {
+		return fmt.Errorf("csvio: Write requires a slice, got %s", rv.Kind())
+	}
+
+	enc := NewEncoder(w, firstOptions(opts))
+	for i := 0; i < rv.Len(); i++ {
+		if err := enc.Encode(rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return enc.Flush()
+}
+
+// Marshal encodes data, which must be a slice of structs or pointers to
+// structs, to CSV bytes.
+func Marshal(data interface{}, opts ...Options) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := Write(&buf, data, opts...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Read decodes CSV rows from r into out, which must be a pointer to a slice
+// of structs (*[]T).
+func Read(r io.Reader, out interface{}, opts ...Options) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("csvio: Read requires a pointer to a slice, got %s", rv.Type())
+	}
+	sliceVal := rv.Elem()
+	elemType := sliceVal.Type().Elem()
+
+	dec := NewDecoder(r, firstOptions(opts))
+	for {
+		elemPtr := reflect.New(elemType)
+		if err := dec.Decode(elemPtr.Interface()); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		sliceVal.Set(reflect.Append(sliceVal, elemPtr.Elem()))
+	}
+	return nil
+}
+
+// SaveCSV writes data, which must be a slice of structs or pointers to
+// structs, to filename as CSV, creating or truncating the file. Unlike
+// guti.SaveAsCSV, struct fields may be any type supported by Encoder, not
+// just string.
+func SaveCSV(filename string, data interface{}, opts ...Options) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return Write(file, data, opts...)
+}
+
+// LoadCSV reads filename as CSV and populates out, which must be a pointer to
+// a slice of structs (*[]T).
+func LoadCSV(filename string, out interface{}, opts ...Options) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return Read(file, out, opts...)
+}
@@ -0,0 +1,95 @@
+package csvio
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+type person struct {
+	Name    string    `csv:"name"`
+	Age     int       `csv:"age"`
+	Score   float64   `csv:"score"`
+	Active  bool      `csv:"active"`
+	Joined  time.Time `csv:"joined"`
+	Ignored string    `csv:"-"`
+}
+
+func TestMarshalUnmarshalRoundTrip(t *testing.T) {
+	joined := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	in := []person{
+		{Name: "Alice", Age: 30, Score: 9.5, Active: true, Joined: joined, Ignored: "skip me"},
+		{Name: "Bob", Age: 25, Score: 7.25, Active: false, Joined: joined},
+	}
+
+	data, err := Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	var out []person
+	if err := Read(bytes.NewReader(data), &out); err != nil {
+		t.Fatalf("Read returned error: %v", err)
+	}
+
+	if len(out) != len(in) {
+		t.Fatalf("got %d rows, want %d", len(out), len(in))
+	}
+	for i := range in {
+		want := in[i]
+		want.Ignored = "" // csv:"-" fields are never round-tripped
+		if out[i] != want {
+			t.Errorf("row %d = %+v, want %+v", i, out[i], want)
+		}
+	}
+}
+
+func TestReadStrictModeUnknownColumn(t *testing.T) {
+	csvData := "name,age,extra\nAlice,30,surprise\n"
+
+	var out []person
+	err := Read(strings.NewReader(csvData), &out, Options{Strict: true})
+	if err == nil {
+		t.Fatal("Read with Strict=true did not return an error for an unknown column")
+	}
+}
+
+func TestReadStrictModeMissingColumn(t *testing.T) {
+	csvData := "name,age\nAlice,30\n"
+
+	var out []person
+	err := Read(strings.NewReader(csvData), &out, Options{Strict: true})
+	if err == nil {
+		t.Fatal("Read with Strict=true did not return an error for a missing column")
+	}
+}
+
+func TestReadNonStrictModeIgnoresMismatchedColumns(t *testing.T) {
+	csvData := "name,age,extra\nAlice,30,surprise\n"
+
+	var out []person
+	if err := Read(strings.NewReader(csvData), &out); err != nil {
+		t.Fatalf("Read returned unexpected error: %v", err)
+	}
+	if len(out) != 1 || out[0].Name != "Alice" || out[0].Age != 30 {
+		t.Errorf("got %+v, want Name=Alice Age=30", out)
+	}
+}
+
+func TestOmitEmpty(t *testing.T) {
+	type row struct {
+		Name  string `csv:"name"`
+		Notes string `csv:"notes,omitempty"`
+	}
+
+	data, err := Marshal([]row{{Name: "Alice"}})
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	want := "name,notes\nAlice,\n"
+	if string(data) != want {
+		t.Errorf("Marshal output = %q, want %q", string(data), want)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"math"
 	"os"
 	"reflect"
+
+	"github.com/shahariaazam/gotil/slices"
 )
 
 const epsilon = 1e-6
@@ -53,42 +55,55 @@ func IsExist(what interface{}, in interface{}) bool {
 		panic("IsExist: Second argument must be a slice")
 	}
 
+	whatValue := reflect.ValueOf(what)
 	for i := 0; i < s.Len(); i++ {
-		if s.Index(i).Kind() != reflect.TypeOf(what).Kind() {
-			continue
-		}
-
-		switch s.Index(i).Kind() {
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			if s.Index(i).Int() == reflect.ValueOf(what).Int() {
-				return true
-			}
-		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			if s.Index(i).Uint() == reflect.ValueOf(what).Uint() {
-				return true
-			}
-		case reflect.Float32, reflect.Float64:
-			if math.Abs(s.Index(i).Float()-reflect.ValueOf(what).Float()) < epsilon {
-				return true
-			}
-		case reflect.String:
-			if s.Index(i).String() == reflect.ValueOf(what).String() {
-				return true
-			}
-		case reflect.Bool:
-			if s.Index(i).Bool() == reflect.ValueOf(what).Bool() {
-				return true
-			}
-		default:
-			if reflect.DeepEqual(what, s.Index(i).Interface()) {
-				return true
-			}
+		if valuesEqual(s.Index(i), whatValue) {
+			return true
 		}
 	}
 
 	return false
 }
 
+// valuesEqual reports whether a and b hold the same value. It is the
+// reflection-based comparison shared by IsExist and the set operations
+// (Unique, Union, Intersection, Difference, ...): values of mismatched kind
+// are never equal, floats are compared within epsilon, and everything else
+// falls back to reflect.DeepEqual. a and b are unwrapped first if they are
+// interface values, as happens when indexing a []interface{} slice, so that
+// the underlying concrete kind is what gets compared.
+func valuesEqual(a, b reflect.Value) bool {
+	for a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	for b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+
+	if a.Kind() != b.Kind() {
+		return false
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() == b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() == b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return math.Abs(a.Float()-b.Float()) < epsilon
+	case reflect.String:
+		return a.String() == b.String()
+	case reflect.Bool:
+		return a.Bool() == b.Bool()
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
 // Filter returns a new list containing the elements of the input list that
 // satisfy the given predicate function. The predicate function takes an input
 // element of the list and returns true if the element should be included in the
@@ -103,13 +118,7 @@ func IsExist(what interface{}, in interface{}) bool {
 //
 // Playground: https://go.dev/play/p/haueBKmeb3e
 func Filter(data []interface{}, predicate func(interface{}) bool) []interface{} {
-	result := []interface{}{}
-	for _, d := range data {
-		if predicate(d) {
-			result = append(result, d)
-		}
-	}
-	return result
+	return slices.Filter(data, predicate)
 }
 
 // Any returns true if at least one element of the input list satisfies the given predicate function,
@@ -125,12 +134,7 @@ func Filter(data []interface{}, predicate func(interface{}) bool) []interface{}
 //
 // Playground: https://go.dev/play/p/mVzWG6tTp_2
 func Any(data []interface{}, predicate func(interface{}) bool) bool {
-	for _, d := range data {
-		if predicate(d) {
-			return true
-		}
-	}
-	return false
+	return slices.Any(data, predicate)
 }
 
 // Reduce applies a reducing function to a list and returns a single value.
@@ -154,11 +158,7 @@ func Any(data []interface{}, predicate func(interface{}) bool) bool {
 //
 // Playground: https://go.dev/play/p/A7ZQrVp_uIk
 func Reduce(data []interface{}, reduce func(interface{}, interface{}) interface{}, initial interface{}) interface{} {
-	acc := initial
-	for _, d := range data {
-		acc = reduce(acc, d)
-	}
-	return acc
+	return slices.Reduce(data, reduce, initial)
 }
 
 // Map applies a transformation function to each element of a slice and returns a new slice with the
@@ -180,11 +180,7 @@ func Reduce(data []interface{}, reduce func(interface{}, interface{}) interface{
 //
 // Playground: https://go.dev/play/p/ZguMfToP0Xh
 func Map(data []interface{}, transform func(interface{}) interface{}) []interface{} {
-	result := []interface{}{}
-	for _, d := range data {
-		result = append(result, transform(d))
-	}
-	return result
+	return slices.Map(data, transform)
 }
 
 // IndexOf returns the index of the first occurrence of a given element in a list. If the element is not found, it returns -1.
@@ -202,38 +198,17 @@ func Map(data []interface{}, transform func(interface{}) interface{}) []interfac
 //
 // Playground: https://go.dev/play/p/K7X-4_RbJPG
 func IndexOf(data []interface{}, element interface{}) int {
-	for i, d := range data {
-		if d == element {
-			return i
-		}
-	}
-	return -1
+	return slices.IndexOf(data, element)
 }
 
 // ContainsAll returns true if all elements in the first slice are present in the second slice, otherwise returns false.
 func ContainsAll(s1, s2 []interface{}) bool {
-	for _, e1 := range s1 {
-		found := false
-		for _, e2 := range s2 {
-			if e1 == e2 {
-				found = true
-				break
-			}
-		}
-		if !found {
-			return false
-		}
-	}
-	return true
+	return slices.ContainsAll(s1, s2)
 }
 
 // Reverse returns a new slice with the elements of the given slice in reverse order.
 func Reverse(slice []interface{}) []interface{} {
-	result := make([]interface{}, len(slice))
-	for i, j := 0, len(slice)-1; i <= j; i, j = i+1, j-1 {
-		result[i], result[j] = slice[j], slice[i]
-	}
-	return result
+	return slices.Reverse(slice)
 }
 
 // FilterNil returns a new slice with all nil elements removed from the given slice.
@@ -264,29 +239,6 @@ func MapReduce(items interface{}, mapper func(interface{}) interface{}, reducer
 	return reducedResult
 }
 
-// Batch takes a slice of items and a batch size, and returns a slice of slices, where each inner slice contains at most batchSize items from the input slice
-func Batch(items interface{}, batchSize int) [][]interface{} {
-	var batches [][]interface{}
-	itemsValue := reflect.ValueOf(items)
-	batchSize = int(math.Min(float64(batchSize), float64(itemsValue.Len())))
-
-	for i := 0; i < itemsValue.Len(); i += batchSize {
-		end := int(math.Min(float64(i+batchSize), float64(itemsValue.Len())))
-		batches = append(batches, ConvertSliceInterfaceToSlice(itemsValue.Slice(i, end)))
-	}
-
-	return batches
-}
-
-// ConvertSliceInterfaceToSlice takes a reflect.Value of a slice of unknown type and returns a new slice of interface{} type
-func ConvertSliceInterfaceToSlice(slice reflect.Value) []interface{} {
-	s := make([]interface{}, slice.Len())
-	for i := 0; i < slice.Len(); i++ {
-		s[i] = slice.Index(i).Interface()
-	}
-	return s
-}
-
 // SaveAsCSV save data to csv
 func SaveAsCSV(data interface{}, filename string) error {
 	file, err := os.Create(filename)
@@ -0,0 +1,80 @@
+package guti
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterDelegates(t *testing.T) {
+	data := []interface{}{1, 2, 3, 4, 5}
+	isEven := func(x interface{}) bool { return x.(int)%2 == 0 }
+
+	got := Filter(data, isEven)
+	want := []interface{}{2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+}
+
+func TestMapDelegates(t *testing.T) {
+	data := []interface{}{1, 2, 3, 4, 5}
+	double := func(x interface{}) interface{} { return x.(int) * 2 }
+
+	got := Map(data, double)
+	want := []interface{}{2, 4, 6, 8, 10}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, want %v", got, want)
+	}
+}
+
+func TestReduceDelegates(t *testing.T) {
+	data := []interface{}{1, 2, 3, 4, 5}
+	sum := func(acc, v interface{}) interface{} { return acc.(int) + v.(int) }
+
+	got := Reduce(data, sum, 0)
+	if got != 15 {
+		t.Errorf("Reduce() = %v, want 15", got)
+	}
+}
+
+func TestAnyDelegates(t *testing.T) {
+	data := []interface{}{1, 2, 3, 4, 5}
+	isEven := func(x interface{}) bool { return x.(int)%2 == 0 }
+
+	if !Any(data, isEven) {
+		t.Error("Any() = false, want true")
+	}
+	if Any([]interface{}{1, 3, 5}, isEven) {
+		t.Error("Any() = true, want false")
+	}
+}
+
+func TestIndexOfDelegates(t *testing.T) {
+	data := []interface{}{"apple", "banana", "cherry"}
+	if got := IndexOf(data, "banana"); got != 1 {
+		t.Errorf("IndexOf() = %d, want 1", got)
+	}
+	if got := IndexOf(data, "missing"); got != -1 {
+		t.Errorf("IndexOf() = %d, want -1", got)
+	}
+}
+
+func TestContainsAllDelegates(t *testing.T) {
+	s1 := []interface{}{1, 2}
+	s2 := []interface{}{1, 2, 3}
+	if !ContainsAll(s1, s2) {
+		t.Error("ContainsAll() = false, want true")
+	}
+	if ContainsAll([]interface{}{1, 9}, s2) {
+		t.Error("ContainsAll() = true, want false")
+	}
+}
+
+func TestReverseDelegates(t *testing.T) {
+	got := Reverse([]interface{}{1, 2, 3})
+	want := []interface{}{3, 2, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse() = %v, want %v", got, want)
+	}
+}
+
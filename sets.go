@@ -0,0 +1,176 @@
+package guti
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Unique returns a new slice with duplicate elements removed, preserving the
+// order of first occurrence. Equality is determined using the same
+// reflection-based rules as IsExist.
+func Unique(slice []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(slice))
+	for _, v := range slice {
+		if !IsExist(v, result) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Union returns a new slice containing the unique elements present in either
+// s1 or s2, preserving the order in which they first appear across s1 then s2.
+func Union(s1, s2 []interface{}) []interface{} {
+	combined := make([]interface{}, 0, len(s1)+len(s2))
+	combined = append(combined, s1...)
+	combined = append(combined, s2...)
+	return Unique(combined)
+}
+
+// Intersection returns a new slice containing the unique elements present in
+// both s1 and s2.
+func Intersection(s1, s2 []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+	for _, v := range Unique(s1) {
+		if IsExist(v, s2) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Difference returns the unique elements of s1 that are not present in s2.
+// Use SymmetricDifference to also include the elements of s2 that are not in s1.
+func Difference(s1, s2 []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+	for _, v := range Unique(s1) {
+		if !IsExist(v, s2) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns the unique elements present in exactly one of
+// s1 or s2.
+func SymmetricDifference(s1, s2 []interface{}) []interface{} {
+	return append(Difference(s1, s2), Difference(s2, s1)...)
+}
+
+// GroupBy groups the elements of slice by the key returned by keyFn. Because
+// the result is a native Go map, which can only bucket on exact key equality,
+// keys are first bucketed with a linear scan using the same reflection-based
+// valuesEqual comparison as IsExist (including the float epsilon tolerance);
+// the first key value seen for a bucket is the one stored in the result.
+func GroupBy(slice []interface{}, keyFn func(interface{}) interface{}) map[interface{}][]interface{} {
+	result := make(map[interface{}][]interface{})
+	var keys []interface{}
+
+	for _, v := range slice {
+		key := bucketKey(&keys, keyFn(v))
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+// CountBy counts the elements of slice by the key returned by keyFn, bucketing
+// keys the same way GroupBy does.
+func CountBy(slice []interface{}, keyFn func(interface{}) interface{}) map[interface{}]int {
+	result := make(map[interface{}]int)
+	var keys []interface{}
+
+	for _, v := range slice {
+		key := bucketKey(&keys, keyFn(v))
+		result[key]++
+	}
+	return result
+}
+
+// bucketKey returns the representative key for key: if keys already holds one
+// that valuesEqual considers equal, that existing key is returned unchanged;
+// otherwise key is appended to keys and returned as its own representative.
+func bucketKey(keys *[]interface{}, key interface{}) interface{} {
+	keyValue := reflect.ValueOf(key)
+	for _, existing := range *keys {
+		if valuesEqual(reflect.ValueOf(existing), keyValue) {
+			return existing
+		}
+	}
+	*keys = append(*keys, key)
+	return key
+}
+
+// Partition splits slice into two slices: matching contains the elements for
+// which predicate returns true, and rest contains the remainder.
+func Partition(slice []interface{}, predicate func(interface{}) bool) (matching, rest []interface{}) {
+	for _, v := range slice {
+		if predicate(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matching, rest
+}
+
+// ToMap turns a slice of structs or struct pointers into a map keyed by the
+// value of their pivotField, e.g. turning a []*Foo into a map[KeyType]*Foo.
+// It panics if slice is not a slice or if its element type has no field named
+// pivotField. Pivot values are bucketed the same way GroupBy buckets its keys,
+// using the reflection-based valuesEqual comparison IsExist uses (including
+// the float epsilon tolerance); if more than one element shares a bucket, the
+// last one wins.
+func ToMap(slice interface{}, pivotField string) map[interface{}]interface{} {
+	s := reflect.ValueOf(slice)
+	if s.Kind() != reflect.Slice {
+		panic("ToMap: first argument must be a slice")
+	}
+
+	result := make(map[interface{}]interface{}, s.Len())
+	var keys []interface{}
+
+	for i := 0; i < s.Len(); i++ {
+		item := s.Index(i)
+
+		target := item
+		for target.Kind() == reflect.Ptr {
+			target = target.Elem()
+		}
+
+		field := target.FieldByName(pivotField)
+		if !field.IsValid() {
+			panic(fmt.Sprintf("ToMap: field %q not found", pivotField))
+		}
+
+		key := bucketKey(&keys, field.Interface())
+		result[key] = item.Interface()
+	}
+	return result
+}
+
+// Zip combines s1 and s2 into a slice of [2]interface{} pairs, truncated to
+// the length of the shorter slice.
+func Zip(s1, s2 []interface{}) [][2]interface{} {
+	n := len(s1)
+	if len(s2) < n {
+		n = len(s2)
+	}
+
+	result := make([][2]interface{}, n)
+	for i := 0; i < n; i++ {
+		result[i] = [2]interface{}{s1[i], s2[i]}
+	}
+	return result
+}
+
+// Unzip splits a slice of [2]interface{} pairs, as produced by Zip, back into
+// two slices.
+func Unzip(pairs [][2]interface{}) (s1, s2 []interface{}) {
+	s1 = make([]interface{}, len(pairs))
+	s2 = make([]interface{}, len(pairs))
+	for i, p := range pairs {
+		s1[i] = p[0]
+		s2[i] = p[1]
+	}
+	return s1, s2
+}
@@ -0,0 +1,212 @@
+package guti
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestIsExistBasics(t *testing.T) {
+	intSlice := []int{1, 2, 3, 4, 5}
+	if !IsExist(3, intSlice) {
+		t.Error("IsExist(3) = false, want true")
+	}
+	if IsExist(6, intSlice) {
+		t.Error("IsExist(6) = true, want false")
+	}
+
+	if !IsExist("foo", []string{"foo", "bar"}) {
+		t.Error(`IsExist("foo") = false, want true`)
+	}
+
+	// Mismatched kinds are skipped, not matched.
+	if IsExist("3", intSlice) {
+		t.Error(`IsExist("3") against []int = true, want false`)
+	}
+}
+
+func TestIsExistFloatEpsilon(t *testing.T) {
+	floats := []float64{1.0, 2.0000001, 3.0}
+	if !IsExist(2.00000015, floats) {
+		t.Error("IsExist() did not treat near-equal floats as equal")
+	}
+	if IsExist(2.1, floats) {
+		t.Error("IsExist() treated distinct floats as equal")
+	}
+}
+
+func TestIsExistPanicsOnNonSlice(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("IsExist() did not panic for a non-slice second argument")
+		}
+	}()
+	IsExist(1, 2)
+}
+
+func TestUnique(t *testing.T) {
+	got := Unique([]interface{}{1, 2, 2, 3, 1})
+	want := []interface{}{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique() = %v, want %v", got, want)
+	}
+}
+
+func TestUniqueFloatEpsilon(t *testing.T) {
+	got := Unique([]interface{}{1.0, 1.0000001, 2.0})
+	want := []interface{}{1.0, 2.0}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique() = %v, want %v", got, want)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	got := Union([]interface{}{1, 2, 3}, []interface{}{3, 4, 5})
+	want := []interface{}{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	got := Intersection([]interface{}{1, 2, 3}, []interface{}{2, 3, 4})
+	want := []interface{}{2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersection() = %v, want %v", got, want)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	got := Difference([]interface{}{1, 2, 3}, []interface{}{2, 3, 4})
+	want := []interface{}{1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Difference() = %v, want %v", got, want)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	got := SymmetricDifference([]interface{}{1, 2, 3}, []interface{}{2, 3, 4})
+	sort.Slice(got, func(i, j int) bool { return got[i].(int) < got[j].(int) })
+	want := []interface{}{1, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SymmetricDifference() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	isEven := func(v interface{}) interface{} { return v.(int)%2 == 0 }
+	got := GroupBy([]interface{}{1, 2, 3, 4, 5}, isEven)
+	want := map[interface{}][]interface{}{
+		false: {1, 3, 5},
+		true:  {2, 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupByFloatEpsilon(t *testing.T) {
+	// Keys that differ by less than epsilon must land in the same bucket,
+	// under the first key value seen for that bucket.
+	keyFn := func(v interface{}) interface{} { return v.(float64) }
+	got := GroupBy([]interface{}{1.0, 1.0000001, 2.0}, keyFn)
+	want := map[interface{}][]interface{}{
+		1.0: {1.0, 1.0000001},
+		2.0: {2.0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	isEven := func(v interface{}) interface{} { return v.(int)%2 == 0 }
+	got := CountBy([]interface{}{1, 2, 3, 4, 5}, isEven)
+	want := map[interface{}]int{false: 3, true: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CountBy() = %v, want %v", got, want)
+	}
+}
+
+func TestCountByFloatEpsilon(t *testing.T) {
+	keyFn := func(v interface{}) interface{} { return v.(float64) }
+	got := CountBy([]interface{}{1.0, 1.0000001, 2.0}, keyFn)
+	want := map[interface{}]int{1.0: 2, 2.0: 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CountBy() = %v, want %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(v interface{}) bool { return v.(int)%2 == 0 }
+	matching, rest := Partition([]interface{}{1, 2, 3, 4, 5}, isEven)
+	if want := []interface{}{2, 4}; !reflect.DeepEqual(matching, want) {
+		t.Errorf("Partition() matching = %v, want %v", matching, want)
+	}
+	if want := []interface{}{1, 3, 5}; !reflect.DeepEqual(rest, want) {
+		t.Errorf("Partition() rest = %v, want %v", rest, want)
+	}
+}
+
+func TestToMap(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []*user{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}}
+
+	got := ToMap(users, "ID")
+	want := map[interface{}]interface{}{1: users[0], 2: users[1]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMap() = %v, want %v", got, want)
+	}
+}
+
+func TestToMapFloatEpsilon(t *testing.T) {
+	type row struct {
+		Key   float64
+		Value string
+	}
+	rows := []row{{Key: 1.0, Value: "first"}, {Key: 1.0000001, Value: "second"}}
+
+	got := ToMap(rows, "Key")
+	want := map[interface{}]interface{}{1.0: row{Key: 1.0000001, Value: "second"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMap() = %v, want %v", got, want)
+	}
+}
+
+func TestToMapPanicsOnNonSlice(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("ToMap() did not panic for a non-slice argument")
+		}
+	}()
+	ToMap(42, "ID")
+}
+
+func TestToMapPanicsOnUnknownField(t *testing.T) {
+	type user struct{ ID int }
+	defer func() {
+		if recover() == nil {
+			t.Error("ToMap() did not panic for an unknown pivotField")
+		}
+	}()
+	ToMap([]user{{ID: 1}}, "DoesNotExist")
+}
+
+func TestZipUnzip(t *testing.T) {
+	pairs := Zip([]interface{}{"a", "b", "c"}, []interface{}{1, 2})
+	want := [][2]interface{}{{"a", 1}, {"b", 2}}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Errorf("Zip() = %v, want %v", pairs, want)
+	}
+
+	s1, s2 := Unzip(pairs)
+	if want := []interface{}{"a", "b"}; !reflect.DeepEqual(s1, want) {
+		t.Errorf("Unzip() s1 = %v, want %v", s1, want)
+	}
+	if want := []interface{}{1, 2}; !reflect.DeepEqual(s2, want) {
+		t.Errorf("Unzip() s2 = %v, want %v", s2, want)
+	}
+}
@@ -0,0 +1,71 @@
+package slices
+
+import "context"
+
+// Batch splits items into consecutive batches of at most batchSize elements.
+// The final batch may be smaller than batchSize when the length of items does
+// not divide evenly. It returns nil if items is empty or batchSize is not
+// positive.
+func Batch[T any](items []T, batchSize int) [][]T {
+	if len(items) == 0 || batchSize <= 0 {
+		return nil
+	}
+
+	var batches [][]T
+	for i := 0; i < len(items); i += batchSize {
+		end := i + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+		batches = append(batches, items[i:end])
+	}
+
+	return batches
+}
+
+// BatchStream splits items into batches of at most batchSize elements and
+// streams them over the returned channel, without ever materializing all
+// batches at once. The channel is closed once items is exhausted or ctx is
+// canceled.
+func BatchStream[T any](ctx context.Context, items []T, batchSize int) <-chan []T {
+	out := make(chan []T)
+
+	go func() {
+		defer close(out)
+
+		if len(items) == 0 || batchSize <= 0 {
+			return
+		}
+
+		for i := 0; i < len(items); i += batchSize {
+			end := i + batchSize
+			if end > len(items) {
+				end = len(items)
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			select {
+			case out <- items[i:end]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// BatchFunc iterates over items in batches of at most batchSize elements,
+// calling fn with each batch in turn. It stops and returns the first error fn
+// returns.
+func BatchFunc[T any](items []T, batchSize int, fn func([]T) error) error {
+	for _, batch := range Batch(items, batchSize) {
+		if err := fn(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package slices
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	got := Union([]int{1, 2, 3}, []int{3, 4, 5})
+	want := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	got := Intersection([]int{1, 2, 3}, []int{2, 3, 4})
+	want := []int{2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersection() = %v, want %v", got, want)
+	}
+}
+
+func TestDifference(t *testing.T) {
+	got := Difference([]int{1, 2, 3}, []int{2, 3, 4})
+	want := []int{1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Difference() = %v, want %v", got, want)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	got := SymmetricDifference([]int{1, 2, 3}, []int{2, 3, 4})
+	sort.Ints(got)
+	want := []int{1, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SymmetricDifference() = %v, want %v", got, want)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	got := GroupBy([]int{1, 2, 3, 4, 5}, isEven)
+	want := map[bool][]int{
+		false: {1, 3, 5},
+		true:  {2, 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GroupBy() = %v, want %v", got, want)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	got := CountBy([]int{1, 2, 3, 4, 5}, isEven)
+	want := map[bool]int{false: 3, true: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CountBy() = %v, want %v", got, want)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	matching, rest := Partition([]int{1, 2, 3, 4, 5}, isEven)
+	if want := []int{2, 4}; !reflect.DeepEqual(matching, want) {
+		t.Errorf("Partition() matching = %v, want %v", matching, want)
+	}
+	if want := []int{1, 3, 5}; !reflect.DeepEqual(rest, want) {
+		t.Errorf("Partition() rest = %v, want %v", rest, want)
+	}
+}
+
+func TestToMap(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob"}}
+
+	got := ToMap(users, func(u user) int { return u.ID })
+	want := map[int]user{1: {ID: 1, Name: "Alice"}, 2: {ID: 2, Name: "Bob"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMap() = %v, want %v", got, want)
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	pairs := Zip([]string{"a", "b", "c"}, []int{1, 2})
+	want := []Pair[string, int]{{First: "a", Second: 1}, {First: "b", Second: 2}}
+	if !reflect.DeepEqual(pairs, want) {
+		t.Errorf("Zip() = %v, want %v", pairs, want)
+	}
+
+	s1, s2 := Unzip(pairs)
+	if wantS1 := []string{"a", "b"}; !reflect.DeepEqual(s1, wantS1) {
+		t.Errorf("Unzip() s1 = %v, want %v", s1, wantS1)
+	}
+	if wantS2 := []int{1, 2}; !reflect.DeepEqual(s2, wantS2) {
+		t.Errorf("Unzip() s2 = %v, want %v", s2, wantS2)
+	}
+}
@@ -0,0 +1,255 @@
+// Package slices provides generic, type-safe equivalents of the interface{}-based
+// slice helpers found in the root guti package. They behave the same way but avoid
+// type assertions in caller code.
+package slices
+
+// Filter returns a new slice containing the elements of data that satisfy predicate.
+//
+// Example usage:
+//
+//	data := []int{1, 2, 3, 4, 5}
+//	isEven := func(x int) bool { return x%2 == 0 }
+//	result := slices.Filter(data, isEven) // []int{2, 4}
+func Filter[T any](data []T, predicate func(T) bool) []T {
+	result := make([]T, 0)
+	for _, d := range data {
+		if predicate(d) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// Map applies transform to each element of data and returns a new slice of the
+// transformed values. The input slice is not modified.
+//
+// Example usage:
+//
+//	input := []int{1, 2, 3}
+//	double := func(x int) int { return x * 2 }
+//	output := slices.Map(input, double) // []int{2, 4, 6}
+func Map[T, U any](data []T, transform func(T) U) []U {
+	result := make([]U, 0, len(data))
+	for _, d := range data {
+		result = append(result, transform(d))
+	}
+	return result
+}
+
+// Reduce applies reduce to each element of data, threading an accumulator that
+// starts at initial, and returns the final accumulator value.
+//
+// Example usage:
+//
+//	data := []int{1, 2, 3, 4, 5}
+//	sum := func(acc, value int) int { return acc + value }
+//	result := slices.Reduce(data, sum, 0) // 15
+func Reduce[T, U any](data []T, reduce func(U, T) U, initial U) U {
+	acc := initial
+	for _, d := range data {
+		acc = reduce(acc, d)
+	}
+	return acc
+}
+
+// Any returns true if at least one element of data satisfies predicate.
+func Any[T any](data []T, predicate func(T) bool) bool {
+	for _, d := range data {
+		if predicate(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// All returns true if every element of data satisfies predicate. It returns true
+// for an empty slice.
+func All[T any](data []T, predicate func(T) bool) bool {
+	for _, d := range data {
+		if !predicate(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// IndexOf returns the index of the first occurrence of element in data, or -1 if
+// element is not found.
+//
+// Example usage:
+//
+//	data := []string{"apple", "banana", "cherry"}
+//	index := slices.IndexOf(data, "banana") // 1
+func IndexOf[T comparable](data []T, element T) int {
+	for i, d := range data {
+		if d == element {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains returns true if element is present in data.
+func Contains[T comparable](data []T, element T) bool {
+	return IndexOf(data, element) != -1
+}
+
+// ContainsAll returns true if every element of s1 is present in s2.
+func ContainsAll[T comparable](s1, s2 []T) bool {
+	for _, e1 := range s1 {
+		if !Contains(s2, e1) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reverse returns a new slice with the elements of slice in reverse order.
+func Reverse[T any](slice []T) []T {
+	result := make([]T, len(slice))
+	for i, j := 0, len(slice)-1; i <= j; i, j = i+1, j-1 {
+		result[i], result[j] = slice[j], slice[i]
+	}
+	return result
+}
+
+// Unique returns a new slice with duplicate elements removed, preserving the
+// order of first occurrence.
+func Unique[T comparable](slice []T) []T {
+	seen := make(map[T]struct{}, len(slice))
+	result := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// Union returns a new slice containing the unique elements present in either
+// s1 or s2, preserving the order in which they first appear across s1 then s2.
+func Union[T comparable](s1, s2 []T) []T {
+	combined := make([]T, 0, len(s1)+len(s2))
+	combined = append(combined, s1...)
+	combined = append(combined, s2...)
+	return Unique(combined)
+}
+
+// Intersection returns a new slice containing the unique elements present in
+// both s1 and s2.
+func Intersection[T comparable](s1, s2 []T) []T {
+	result := make([]T, 0)
+	for _, v := range Unique(s1) {
+		if Contains(s2, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Difference returns the unique elements of s1 that are not present in s2.
+// Use SymmetricDifference to also include the elements of s2 that are not in s1.
+func Difference[T comparable](s1, s2 []T) []T {
+	result := make([]T, 0)
+	for _, v := range Unique(s1) {
+		if !Contains(s2, v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns the unique elements present in exactly one of
+// s1 or s2.
+func SymmetricDifference[T comparable](s1, s2 []T) []T {
+	return append(Difference(s1, s2), Difference(s2, s1)...)
+}
+
+// GroupBy groups the elements of slice by the key returned by keyFn.
+func GroupBy[T any, K comparable](slice []T, keyFn func(T) K) map[K][]T {
+	result := make(map[K][]T)
+	for _, v := range slice {
+		key := keyFn(v)
+		result[key] = append(result[key], v)
+	}
+	return result
+}
+
+// CountBy counts the elements of slice by the key returned by keyFn.
+func CountBy[T any, K comparable](slice []T, keyFn func(T) K) map[K]int {
+	result := make(map[K]int)
+	for _, v := range slice {
+		result[keyFn(v)]++
+	}
+	return result
+}
+
+// Partition splits slice into two slices: matching contains the elements for
+// which predicate returns true, and rest contains the remainder.
+func Partition[T any](slice []T, predicate func(T) bool) (matching, rest []T) {
+	for _, v := range slice {
+		if predicate(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matching, rest
+}
+
+// ToMap keys the elements of slice by the value returned by keyFn. If more
+// than one element produces the same key, the last one wins.
+func ToMap[T any, K comparable](slice []T, keyFn func(T) K) map[K]T {
+	result := make(map[K]T, len(slice))
+	for _, v := range slice {
+		result[keyFn(v)] = v
+	}
+	return result
+}
+
+// Pair holds two values of possibly different types, used by Zip and Unzip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines s1 and s2 into a slice of pairs, truncated to the length of
+// the shorter slice.
+func Zip[A, B any](s1 []A, s2 []B) []Pair[A, B] {
+	n := len(s1)
+	if len(s2) < n {
+		n = len(s2)
+	}
+
+	result := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		result[i] = Pair[A, B]{First: s1[i], Second: s2[i]}
+	}
+	return result
+}
+
+// Unzip splits a slice of pairs, as produced by Zip, back into two slices.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	s1 := make([]A, len(pairs))
+	s2 := make([]B, len(pairs))
+	for i, p := range pairs {
+		s1[i] = p.First
+		s2[i] = p.Second
+	}
+	return s1, s2
+}
+
+// IsExist searches for what in in and returns true if it is found. Unlike the
+// reflection-based guti.IsExist, which panics when in is not a slice, it is
+// generic and never panics, since the compiler enforces that in is a slice of
+// the same type as what.
+//
+// Example usage:
+//
+//	intSlice := []int{1, 2, 3, 4, 5}
+//	fmt.Println(slices.IsExist(3, intSlice)) // prints "true"
+func IsExist[T comparable](what T, in []T) bool {
+	return Contains(in, what)
+}
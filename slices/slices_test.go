@@ -0,0 +1,121 @@
+package slices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	got := Filter([]int{1, 2, 3, 4, 5}, func(x int) bool { return x%2 == 0 })
+	want := []int{2, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+}
+
+func TestMap(t *testing.T) {
+	got := Map([]int{1, 2, 3}, func(x int) int { return x * 2 })
+	want := []int{2, 4, 6}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, want %v", got, want)
+	}
+}
+
+func TestMapDifferentTypes(t *testing.T) {
+	got := Map([]int{1, 2, 3}, func(x int) string { return string(rune('a' + x - 1)) })
+	want := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, want %v", got, want)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	got := Reduce([]int{1, 2, 3, 4, 5}, func(acc, v int) int { return acc + v }, 0)
+	if got != 15 {
+		t.Errorf("Reduce() = %d, want 15", got)
+	}
+}
+
+func TestAny(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	if !Any([]int{1, 3, 4}, isEven) {
+		t.Error("Any() = false, want true")
+	}
+	if Any([]int{1, 3, 5}, isEven) {
+		t.Error("Any() = true, want false")
+	}
+	if Any([]int{}, isEven) {
+		t.Error("Any() on empty slice = true, want false")
+	}
+}
+
+func TestAll(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	if !All([]int{2, 4, 6}, isEven) {
+		t.Error("All() = false, want true")
+	}
+	if All([]int{2, 3, 4}, isEven) {
+		t.Error("All() = true, want false")
+	}
+	if !All([]int{}, isEven) {
+		t.Error("All() on empty slice = false, want true")
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	data := []string{"apple", "banana", "cherry"}
+	if got := IndexOf(data, "banana"); got != 1 {
+		t.Errorf("IndexOf() = %d, want 1", got)
+	}
+	if got := IndexOf(data, "missing"); got != -1 {
+		t.Errorf("IndexOf() = %d, want -1", got)
+	}
+}
+
+func TestContains(t *testing.T) {
+	data := []int{1, 2, 3}
+	if !Contains(data, 2) {
+		t.Error("Contains() = false, want true")
+	}
+	if Contains(data, 9) {
+		t.Error("Contains() = true, want false")
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	if !ContainsAll([]int{1, 2}, []int{1, 2, 3}) {
+		t.Error("ContainsAll() = false, want true")
+	}
+	if ContainsAll([]int{1, 9}, []int{1, 2, 3}) {
+		t.Error("ContainsAll() = true, want false")
+	}
+}
+
+func TestReverse(t *testing.T) {
+	got := Reverse([]int{1, 2, 3, 4, 5})
+	want := []int{5, 4, 3, 2, 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse() = %v, want %v", got, want)
+	}
+
+	if got := Reverse([]int{}); len(got) != 0 {
+		t.Errorf("Reverse() on empty slice = %v, want empty", got)
+	}
+}
+
+func TestUnique(t *testing.T) {
+	got := Unique([]int{1, 2, 2, 3, 1, 4})
+	want := []int{1, 2, 3, 4}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unique() = %v, want %v", got, want)
+	}
+}
+
+func TestIsExist(t *testing.T) {
+	if !IsExist(3, []int{1, 2, 3, 4, 5}) {
+		t.Error("IsExist() = false, want true")
+	}
+	if IsExist(6, []int{1, 2, 3, 4, 5}) {
+		t.Error("IsExist() = true, want false")
+	}
+}
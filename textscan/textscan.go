@@ -0,0 +1,326 @@
+// Package textscan implements a small, composable line-oriented parser for
+// structured text formats such as INI, Java .properties, and .env files. A
+// Scanner drives a pluggable set of Matchers over an io.Reader, and the
+// accumulated result is exposed through a Parser. Callers can extend parsing
+// to other dialects by supplying their own Matcher implementations.
+package textscan
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Kind identifies the kind of line a Token was produced from.
+type Kind int
+
+const (
+	// KindSection marks an INI-style [section] header.
+	KindSection Kind = iota
+	// KindPair marks a key/value pair.
+	KindPair
+	// KindComment marks a comment line.
+	KindComment
+)
+
+// Token is a single parsed unit of input.
+type Token struct {
+	Kind    Kind
+	Section string
+	Key     string
+	Value   string
+	Line    int
+}
+
+// Matcher recognizes and processes one kind of line. Detect reports whether
+// line should be handled by this Matcher. Scan is only called when Detect
+// returned true for the same line, and should emit exactly one Token onto p.
+type Matcher interface {
+	Detect(line string) bool
+	Scan(p *Parser, line string) error
+}
+
+// Parser accumulates the Tokens produced by a Scanner's Matchers and exposes
+// ways to read the result back out.
+type Parser struct {
+	Tokens  []Token
+	section string
+}
+
+// Emit appends a token to the parser's accumulated Tokens. Matchers call this
+// from Scan.
+func (p *Parser) Emit(t Token) {
+	p.Tokens = append(p.Tokens, t)
+}
+
+// Section returns the name of the current INI section, or "" if no section
+// header has been seen yet.
+func (p *Parser) Section() string {
+	return p.section
+}
+
+// SetSection sets the current INI section. SectionMatcher calls this from
+// Scan so that later PairMatcher tokens are attributed to the right section.
+func (p *Parser) SetSection(name string) {
+	p.section = name
+}
+
+// AsMap flattens every key/value token into a single map, ignoring section
+// structure. If the same key appears more than once, the last value wins.
+func (p *Parser) AsMap() map[string]string {
+	result := make(map[string]string)
+	for _, t := range p.Tokens {
+		if t.Kind == KindPair {
+			result[t.Key] = t.Value
+		}
+	}
+	return result
+}
+
+// AsSections groups key/value tokens by their Section. Keys parsed before any
+// section header are grouped under the empty string.
+func (p *Parser) AsSections() map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	for _, t := range p.Tokens {
+		if t.Kind != KindPair {
+			continue
+		}
+		section := result[t.Section]
+		if section == nil {
+			section = make(map[string]string)
+			result[t.Section] = section
+		}
+		section[t.Key] = t.Value
+	}
+	return result
+}
+
+// Unmarshal populates out, a pointer to a struct, from the parser's tokens.
+// Fields are matched using an `ini:"section.key"` tag; a tag with no section,
+// e.g. `ini:"key"`, matches a key parsed outside of any section. Fields
+// without a matching key are left unchanged. Only string fields are
+// currently supported.
+func (p *Parser) Unmarshal(out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("textscan: Unmarshal requires a pointer to struct, got %s", rv.Kind())
+	}
+	elem := rv.Elem()
+	sections := p.AsSections()
+
+	t := elem.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath != "" {
+			continue
+		}
+
+		tag := t.Field(i).Tag.Get("ini")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		section, key := "", tag
+		if idx := strings.LastIndex(tag, "."); idx != -1 {
+			section, key = tag[:idx], tag[idx+1:]
+		}
+
+		value, ok := sections[section][key]
+		if !ok {
+			continue
+		}
+
+		field := elem.Field(i)
+		if field.Kind() != reflect.String {
+			return fmt.Errorf("textscan: field %q: unsupported type %s", t.Field(i).Name, field.Kind())
+		}
+		field.SetString(value)
+	}
+
+	return nil
+}
+
+// SectionMatcher recognizes INI-style "[section]" headers.
+type SectionMatcher struct{}
+
+// Detect reports whether line is a "[section]" header.
+func (SectionMatcher) Detect(line string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]")
+}
+
+// Scan records line as the parser's current section.
+func (SectionMatcher) Scan(p *Parser, line string) error {
+	name := strings.TrimSpace(line)
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "["), "]")
+	p.SetSection(name)
+	p.Emit(Token{Kind: KindSection, Section: name})
+	return nil
+}
+
+// CommentMatcher recognizes comment lines starting with one of Prefixes.
+type CommentMatcher struct {
+	Prefixes []string
+}
+
+// NewCommentMatcher returns a CommentMatcher recognizing lines starting with
+// any of prefixes, e.g. "#", ";", "//".
+func NewCommentMatcher(prefixes ...string) CommentMatcher {
+	return CommentMatcher{Prefixes: prefixes}
+}
+
+// Detect reports whether line starts with one of m.Prefixes.
+func (m CommentMatcher) Detect(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	for _, prefix := range m.Prefixes {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Scan records line as a comment token.
+func (m CommentMatcher) Scan(p *Parser, line string) error {
+	p.Emit(Token{Kind: KindComment, Section: p.Section(), Value: strings.TrimSpace(line)})
+	return nil
+}
+
+// PairMatcher recognizes "key<sep>value" lines, trying each of Separators in
+// turn and splitting on whichever occurs earliest in the line.
+type PairMatcher struct {
+	Separators []string
+}
+
+// NewPairMatcher returns a PairMatcher that splits on the first of seps found
+// in a line, e.g. "=", ":".
+func NewPairMatcher(seps ...string) PairMatcher {
+	return PairMatcher{Separators: seps}
+}
+
+// Detect reports whether line contains one of m.Separators.
+func (m PairMatcher) Detect(line string) bool {
+	_, _, ok := m.split(line)
+	return ok
+}
+
+// Scan splits line into a key and value and records them as a pair token,
+// attributed to the parser's current section.
+func (m PairMatcher) Scan(p *Parser, line string) error {
+	key, value, ok := m.split(line)
+	if !ok {
+		return fmt.Errorf("textscan: no separator found in line %q", line)
+	}
+
+	p.Emit(Token{
+		Kind:    KindPair,
+		Section: p.Section(),
+		Key:     key,
+		Value:   value,
+	})
+	return nil
+}
+
+func (m PairMatcher) split(line string) (key, value string, ok bool) {
+	bestIdx := -1
+	bestSep := ""
+	for _, sep := range m.Separators {
+		if idx := strings.Index(line, sep); idx != -1 && (bestIdx == -1 || idx < bestIdx) {
+			bestIdx = idx
+			bestSep = sep
+		}
+	}
+	if bestIdx == -1 {
+		return "", "", false
+	}
+
+	key = strings.TrimSpace(line[:bestIdx])
+	value = strings.TrimSpace(line[bestIdx+len(bestSep):])
+	return key, value, true
+}
+
+// Scanner reads lines from an io.Reader and dispatches each to the first
+// Matcher whose Detect returns true, accumulating the result in a Parser.
+// Lines ending in a trailing "\" are joined with the following line before
+// matching, supporting multi-line continuations.
+type Scanner struct {
+	Matchers []Matcher
+}
+
+// NewScanner returns a Scanner that tries matchers, in order, against each
+// line.
+func NewScanner(matchers ...Matcher) *Scanner {
+	return &Scanner{Matchers: matchers}
+}
+
+// DefaultMatchers returns the built-in Matchers for INI/.properties/.env-style
+// text: section headers, "#"/";"/"//" comments, and "="/":" key-value pairs.
+func DefaultMatchers() []Matcher {
+	return []Matcher{
+		SectionMatcher{},
+		NewCommentMatcher("#", ";", "//"),
+		NewPairMatcher("=", ":"),
+	}
+}
+
+// Scan reads r line-by-line and returns the resulting Parser. Blank lines and
+// lines that match no Matcher are ignored.
+func (s *Scanner) Scan(r io.Reader) (*Parser, error) {
+	p := &Parser{}
+
+	lineScanner := bufio.NewScanner(r)
+	lineNo := 0
+	var pending string
+
+	for lineScanner.Scan() {
+		lineNo++
+		line := pending + lineScanner.Text()
+		pending = ""
+
+		if strings.HasSuffix(line, "\\") {
+			pending = strings.TrimSuffix(line, "\\")
+			continue
+		}
+
+		if err := s.scanLine(p, line, lineNo); err != nil {
+			return nil, err
+		}
+	}
+
+	if pending != "" {
+		if err := s.scanLine(p, pending, lineNo); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, lineScanner.Err()
+}
+
+func (s *Scanner) scanLine(p *Parser, line string, lineNo int) error {
+	if strings.TrimSpace(line) == "" {
+		return nil
+	}
+
+	for _, m := range s.Matchers {
+		if !m.Detect(line) {
+			continue
+		}
+		if err := m.Scan(p, line); err != nil {
+			return fmt.Errorf("textscan: line %d: %w", lineNo, err)
+		}
+		if n := len(p.Tokens); n > 0 {
+			p.Tokens[n-1].Line = lineNo
+		}
+		return nil
+	}
+
+	return nil
+}
+
+// Parse reads r using DefaultMatchers and returns the resulting Parser. This
+// covers INI, Java .properties, and .env files with a single call; for other
+// dialects, build a Scanner with custom Matchers instead.
+func Parse(r io.Reader) (*Parser, error) {
+	return NewScanner(DefaultMatchers()...).Scan(r)
+}
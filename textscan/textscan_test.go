@@ -0,0 +1,86 @@
+package textscan
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseSectionsAndComments(t *testing.T) {
+	input := `; top-level comment
+name = app.env
+[server]
+host = localhost
+port: 8080
+# another comment
+`
+
+	p, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	want := map[string]map[string]string{
+		"":       {"name": "app.env"},
+		"server": {"host": "localhost", "port": "8080"},
+	}
+	if got := p.AsSections(); !reflect.DeepEqual(got, want) {
+		t.Errorf("AsSections() = %v, want %v", got, want)
+	}
+}
+
+func TestParseMultilineContinuation(t *testing.T) {
+	input := "name = hello \\\nworld\n"
+
+	p, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	got := p.AsMap()
+	if want := "hello world"; got["name"] != want {
+		t.Errorf("AsMap()[\"name\"] = %q, want %q", got["name"], want)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	input := `[server]
+host = localhost
+port = 8080
+`
+	p, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	var cfg struct {
+		Host     string `ini:"server.host"`
+		Port     string `ini:"server.port"`
+		Untagged string
+		hidden   string `ini:"server.host"`
+	}
+	if err := p.Unmarshal(&cfg); err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	if cfg.Host != "localhost" {
+		t.Errorf("cfg.Host = %q, want %q", cfg.Host, "localhost")
+	}
+	if cfg.Port != "8080" {
+		t.Errorf("cfg.Port = %q, want %q", cfg.Port, "8080")
+	}
+	if cfg.Untagged != "" {
+		t.Errorf("cfg.Untagged = %q, want empty", cfg.Untagged)
+	}
+	if cfg.hidden != "" {
+		t.Errorf("cfg.hidden = %q, want empty (unexported fields must be skipped)", cfg.hidden)
+	}
+}
+
+func TestUnmarshalRejectsNonStruct(t *testing.T) {
+	p := &Parser{}
+	var notAStruct int
+	if err := p.Unmarshal(&notAStruct); err == nil {
+		t.Fatal("Unmarshal did not return an error for a non-struct pointer")
+	}
+}